@@ -0,0 +1,228 @@
+// Command metricsreplay reads back a JSON event stream written by
+// metrics.JSONEventMetricer and re-emits each event into another Metricer,
+// for offline analysis of a past monitor run against Prometheus, StatsD, or
+// any other sink.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func main() {
+	var (
+		input        = flag.String("input", "-", "path to the JSON event stream to replay, or - for stdin")
+		sink         = flag.String("sink", "noop", "sink to replay into: noop, prometheus, statsd")
+		statsdAddr   = flag.String("statsd-addr", "127.0.0.1:8125", "StatsD address, when -sink=statsd")
+		statsdPrefix = flag.String("statsd-prefix", "op_dispute_mon", "StatsD stat prefix, when -sink=statsd")
+		metricsHost  = flag.String("metrics-host", "127.0.0.1", "host to serve /metrics on once replay is done, when -sink=prometheus")
+		metricsPort  = flag.Int("metrics-port", 7310, "port to serve /metrics on once replay is done, when -sink=prometheus")
+	)
+	flag.Parse()
+
+	if err := run(*input, *sink, *statsdAddr, *statsdPrefix, *metricsHost, *metricsPort); err != nil {
+		log.Fatalf("metricsreplay: %v", err)
+	}
+}
+
+func run(input, sink, statsdAddr, statsdPrefix, metricsHost string, metricsPort int) error {
+	r, err := openInput(input)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	m, closeSink, err := openSink(sink, statsdAddr, statsdPrefix)
+	if err != nil {
+		return err
+	}
+	defer closeSink()
+
+	if err := replay(r, m); err != nil {
+		return err
+	}
+
+	if prom, ok := m.(*metrics.PrometheusMetrics); ok {
+		return serveUntilInterrupted(prom, metricsHost, metricsPort)
+	}
+	return nil
+}
+
+// serveUntilInterrupted exposes the replayed Prometheus metrics on
+// /metrics, for an operator to scrape the replayed run, until SIGINT or
+// SIGTERM.
+func serveUntilInterrupted(m *metrics.PrometheusMetrics, host string, port int) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	server, err := m.Start(ctx, host, port)
+	if err != nil {
+		return fmt.Errorf("failed to start metrics server: %w", err)
+	}
+	log.Printf("serving replayed metrics on http://%s/metrics, ctrl-c to exit", server.Addr)
+
+	<-ctx.Done()
+	return nil
+}
+
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	return f, nil
+}
+
+func openSink(sink, statsdAddr, statsdPrefix string) (metrics.Metricer, func(), error) {
+	switch sink {
+	case "noop":
+		return metrics.NoopMetrics, func() {}, nil
+	case "prometheus":
+		return metrics.NewPrometheusMetrics(prometheus.NewRegistry(), metrics.Namespace), func() {}, nil
+	case "statsd":
+		sd, err := metrics.NewStatsDMetrics(statsdAddr, statsdPrefix, 1.0)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create statsd sink: %w", err)
+		}
+		return sd, func() { _ = sd.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown sink %q, expected noop, prometheus or statsd", sink)
+	}
+}
+
+// replay reads one metrics.Event per line from r and forwards it to m,
+// reconstructing the arguments each Metricer method expects from the
+// event's labels, count and value fields.
+func replay(r io.Reader, m metrics.Metricer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	count := 0
+	for scanner.Scan() {
+		var e metrics.Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return fmt.Errorf("failed to decode event %d: %w", count, err)
+		}
+		applyEvent(m, e)
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read event stream: %w", err)
+	}
+
+	log.Printf("replayed %d events", count)
+	return nil
+}
+
+func applyEvent(m metrics.Metricer, e metrics.Event) {
+	switch e.Method {
+	case "RecordInfo":
+		m.RecordInfo(e.Labels["version"])
+	case "RecordUp":
+		m.RecordUp()
+	case "CacheAdd":
+		m.CacheAdd(e.Labels["cache"], e.Count, e.Labels["evicted"] == "true")
+	case "CacheGet":
+		m.CacheGet(e.Labels["cache"], e.Labels["hit"] == "true")
+	case "RecordHonestActorClaims":
+		m.RecordHonestActorClaims(common.HexToAddress(e.Labels["actor"]), &metrics.HonestActorData{
+			Claims:           e.Count,
+			InvalidClaims:    parseInt(e.Labels["invalid_claims"]),
+			MaxBondRequired:  parseBig(e.Labels["bond_required"]),
+			MaxBondAvailable: parseBig(e.Labels["bond_available"]),
+		})
+	case "RecordGameResolutionStatus":
+		m.RecordGameResolutionStatus(e.Labels["in_progress"] == "true", e.Labels["resolved"] == "true", e.Count)
+	case "RecordCredit":
+		m.RecordCredit(parseCreditExpectation(e.Labels["expectation"]), e.Count)
+	case "RecordClaims":
+		m.RecordClaims(parseClaimStatus(e.Labels["status"]), e.Count)
+	case "RecordWithdrawalRequests":
+		m.RecordWithdrawalRequests(common.HexToAddress(e.Labels["delta"]), e.Labels["matches"] == "true", e.Count)
+	case "RecordClaimResolutionDelayMax":
+		m.RecordClaimResolutionDelayMax(e.Value)
+	case "RecordOutputFetchTime":
+		m.RecordOutputFetchTime(e.Value)
+	case "RecordGameAgreement":
+		m.RecordGameAgreement(parseGameAgreementStatus(e.Labels["status"]), e.Count)
+	case "RecordIgnoredGames":
+		m.RecordIgnoredGames(e.Count)
+	case "RecordBondCollateral":
+		m.RecordBondCollateral(common.HexToAddress(e.Labels["address"]), parseBig(e.Labels["required"]), parseBig(e.Labels["available"]))
+	default:
+		log.Printf("skipping unrecognized event method %q", e.Method)
+	}
+}
+
+func parseInt(s string) int {
+	v, _ := strconv.Atoi(s)
+	return v
+}
+
+func parseBig(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return v
+}
+
+func parseCreditExpectation(s string) metrics.CreditExpectation {
+	for _, c := range []metrics.CreditExpectation{
+		metrics.CreditBelowMaxExpectation,
+		metrics.CreditEqualMaxExpectation,
+		metrics.CreditAboveMaxExpectation,
+	} {
+		if c.String() == s {
+			return c
+		}
+	}
+	return metrics.CreditBelowMaxExpectation
+}
+
+func parseClaimStatus(s string) metrics.ClaimStatus {
+	for _, c := range []metrics.ClaimStatus{
+		metrics.ClaimStatusInProgress,
+		metrics.ClaimStatusResolved,
+		metrics.ClaimStatusAgreement,
+		metrics.ClaimStatusDisagreement,
+	} {
+		if c.String() == s {
+			return c
+		}
+	}
+	return metrics.ClaimStatusInProgress
+}
+
+func parseGameAgreementStatus(s string) metrics.GameAgreementStatus {
+	for _, g := range []metrics.GameAgreementStatus{
+		metrics.AgreeChallengerWins,
+		metrics.AgreeDefenderWins,
+		metrics.DisagreeChallengerWins,
+		metrics.DisagreeDefenderWins,
+		metrics.AgreeGameInProgress,
+		metrics.DisagreeGameInProgress,
+	} {
+		if g.String() == s {
+			return g
+		}
+	}
+	return metrics.AgreeGameInProgress
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMetricer captures the arguments of whichever Metricer method
+// applyEvent calls, so tests can assert the event was reconstructed
+// faithfully. Embedding metrics.Metricer satisfies methods this test
+// doesn't exercise.
+type recordingMetricer struct {
+	metrics.Metricer
+
+	actor common.Address
+	data  *metrics.HonestActorData
+}
+
+func (r *recordingMetricer) RecordHonestActorClaims(address common.Address, data *metrics.HonestActorData) {
+	r.actor = address
+	r.data = data
+}
+
+func TestApplyEventRecordHonestActorClaims(t *testing.T) {
+	rec := &recordingMetricer{}
+	addr := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	applyEvent(rec, metrics.Event{
+		Method: "RecordHonestActorClaims",
+		Count:  4,
+		Labels: map[string]string{
+			"actor":          addr.Hex(),
+			"invalid_claims": "2",
+			"bond_required":  "1000",
+			"bond_available": "500",
+		},
+	})
+
+	require.Equal(t, addr, rec.actor)
+	require.Equal(t, 4, rec.data.Claims)
+	require.Equal(t, 2, rec.data.InvalidClaims)
+	require.Equal(t, big.NewInt(1000), rec.data.MaxBondRequired)
+	require.Equal(t, big.NewInt(500), rec.data.MaxBondAvailable)
+}
+
+func TestParseGameAgreementStatusRoundTrip(t *testing.T) {
+	for _, s := range []metrics.GameAgreementStatus{
+		metrics.AgreeChallengerWins,
+		metrics.AgreeDefenderWins,
+		metrics.DisagreeChallengerWins,
+		metrics.DisagreeDefenderWins,
+		metrics.AgreeGameInProgress,
+		metrics.DisagreeGameInProgress,
+	} {
+		require.Equal(t, s, parseGameAgreementStatus(s.String()))
+	}
+}
+
+func TestParseBigHandlesInvalidInput(t *testing.T) {
+	require.Equal(t, big.NewInt(0), parseBig("not-a-number"))
+	require.Equal(t, big.NewInt(42), parseBig("42"))
+}
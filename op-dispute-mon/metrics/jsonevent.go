@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Event is a single line-delimited JSON record emitted by JSONEventMetricer.
+// Method is the Metricer method that produced it; only the fields relevant
+// to that method are populated. Bond amounts are rendered as decimal
+// strings since *big.Int doesn't round-trip through JSON numbers safely.
+type Event struct {
+	Time   time.Time         `json:"time"`
+	Method string            `json:"method"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Value  float64           `json:"value,omitempty"`
+	Count  int               `json:"count,omitempty"`
+}
+
+// JSONEventMetricer is a Metricer implementation that serializes every
+// recorded event as a line-delimited JSON record to an io.Writer. The
+// writer may be a plain file, stdout, a rotating file sink (see
+// NewRotatingFileWriter), or any injected writer backed by Kafka, Redis, or
+// similar, allowing offline replay and audit of historical runs.
+type JSONEventMetricer struct {
+	contractMetrics.ContractMetricer
+
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+var _ Metricer = (*JSONEventMetricer)(nil)
+
+// NewJSONEventMetricer returns a JSONEventMetricer writing to w.
+func NewJSONEventMetricer(w io.Writer) *JSONEventMetricer {
+	return &JSONEventMetricer{
+		ContractMetricer: contractMetrics.NoopMetrics{},
+		enc:              json.NewEncoder(w),
+	}
+}
+
+func (m *JSONEventMetricer) emit(e Event) {
+	e.Time = time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// Encoding errors (e.g. a closed file sink) are deliberately swallowed:
+	// metrics recording must never fail the caller's hot path.
+	_ = m.enc.Encode(e)
+}
+
+func (m *JSONEventMetricer) RecordInfo(version string) {
+	m.emit(Event{Method: "RecordInfo", Labels: map[string]string{"version": version}})
+}
+
+func (m *JSONEventMetricer) RecordUp() {
+	m.emit(Event{Method: "RecordUp"})
+}
+
+func (m *JSONEventMetricer) CacheAdd(label string, cacheSize int, evicted bool) {
+	m.emit(Event{
+		Method: "CacheAdd",
+		Labels: map[string]string{"cache": label, "evicted": fmt.Sprint(evicted)},
+		Count:  cacheSize,
+	})
+}
+
+func (m *JSONEventMetricer) CacheGet(label string, hit bool) {
+	m.emit(Event{
+		Method: "CacheGet",
+		Labels: map[string]string{"cache": label, "hit": fmt.Sprint(hit)},
+	})
+}
+
+func (m *JSONEventMetricer) RecordHonestActorClaims(address common.Address, data *HonestActorData) {
+	m.emit(Event{
+		Method: "RecordHonestActorClaims",
+		Labels: map[string]string{
+			"actor":          address.Hex(),
+			"invalid_claims": strconv.Itoa(data.InvalidClaims),
+			"bond_required":  bigToString(data.MaxBondRequired),
+			"bond_available": bigToString(data.MaxBondAvailable),
+		},
+		Count: data.Claims,
+	})
+}
+
+func (m *JSONEventMetricer) RecordGameResolutionStatus(inProgress bool, resolved bool, count int) {
+	m.emit(Event{
+		Method: "RecordGameResolutionStatus",
+		Labels: map[string]string{"in_progress": fmt.Sprint(inProgress), "resolved": fmt.Sprint(resolved)},
+		Count:  count,
+	})
+}
+
+func (m *JSONEventMetricer) RecordCredit(expectation CreditExpectation, count int) {
+	m.emit(Event{Method: "RecordCredit", Labels: map[string]string{"expectation": expectation.String()}, Count: count})
+}
+
+func (m *JSONEventMetricer) RecordClaims(status ClaimStatus, count int) {
+	m.emit(Event{Method: "RecordClaims", Labels: map[string]string{"status": status.String()}, Count: count})
+}
+
+func (m *JSONEventMetricer) RecordWithdrawalRequests(delta common.Address, matches bool, count int) {
+	m.emit(Event{
+		Method: "RecordWithdrawalRequests",
+		Labels: map[string]string{"delta": delta.Hex(), "matches": fmt.Sprint(matches)},
+		Count:  count,
+	})
+}
+
+func (m *JSONEventMetricer) RecordClaimResolutionDelayMax(delay float64) {
+	m.emit(Event{Method: "RecordClaimResolutionDelayMax", Value: delay})
+}
+
+func (m *JSONEventMetricer) RecordOutputFetchTime(timestamp float64) {
+	m.emit(Event{Method: "RecordOutputFetchTime", Value: timestamp})
+}
+
+func (m *JSONEventMetricer) RecordGameAgreement(status GameAgreementStatus, count int) {
+	m.emit(Event{Method: "RecordGameAgreement", Labels: map[string]string{"status": status.String()}, Count: count})
+}
+
+func (m *JSONEventMetricer) RecordIgnoredGames(count int) {
+	m.emit(Event{Method: "RecordIgnoredGames", Count: count})
+}
+
+func (m *JSONEventMetricer) RecordBondCollateral(addr common.Address, required, available *big.Int) {
+	m.emit(Event{
+		Method: "RecordBondCollateral",
+		Labels: map[string]string{
+			"address":   addr.Hex(),
+			"required":  bigToString(required),
+			"available": bigToString(available),
+		},
+	})
+}
+
+func bigToString(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
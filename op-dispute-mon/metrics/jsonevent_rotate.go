@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"io"
+
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RotatingFileConfig controls the size-based log rotation used by
+// NewRotatingFileWriter.
+type RotatingFileConfig struct {
+	// Filename is the path to the active log file. Rotated files are
+	// written alongside it with a timestamp suffix.
+	Filename string
+	// MaxSizeMB is the size a file can reach before it's rotated.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to retain; 0 keeps them
+	// all.
+	MaxBackups int
+	// MaxAgeDays is the number of days to retain rotated files; 0 disables
+	// age-based cleanup.
+	MaxAgeDays int
+	// Compress gzips rotated files once they age out of active use.
+	Compress bool
+}
+
+// NewRotatingFileWriter returns an io.WriteCloser suitable for passing to
+// NewJSONEventMetricer that rotates cfg.Filename once it exceeds
+// cfg.MaxSizeMB, so a long-running monitor doesn't grow the event log
+// without bound.
+func NewRotatingFileWriter(cfg RotatingFileConfig) io.WriteCloser {
+	return &lumberjack.Logger{
+		Filename:   cfg.Filename,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
+		Compress:   cfg.Compress,
+	}
+}
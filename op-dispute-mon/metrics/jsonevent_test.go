@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONEventMetricerRecordBondCollateral(t *testing.T) {
+	var buf bytes.Buffer
+	m := NewJSONEventMetricer(&buf)
+
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	required := new(big.Int).Exp(big.NewInt(10), big.NewInt(25), nil) // exceeds int64 range
+	available := big.NewInt(7)
+
+	m.RecordBondCollateral(addr, required, available)
+
+	var e Event
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &e))
+	require.Equal(t, "RecordBondCollateral", e.Method)
+	require.Equal(t, addr.Hex(), e.Labels["address"])
+	require.Equal(t, required.String(), e.Labels["required"])
+	require.Equal(t, available.String(), e.Labels["available"])
+}
+
+func TestJSONEventMetricerRecordHonestActorClaims(t *testing.T) {
+	var buf bytes.Buffer
+	m := NewJSONEventMetricer(&buf)
+
+	addr := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	data := &HonestActorData{
+		Claims:           3,
+		InvalidClaims:    1,
+		MaxBondRequired:  big.NewInt(100),
+		MaxBondAvailable: big.NewInt(50),
+	}
+	m.RecordHonestActorClaims(addr, data)
+
+	var e Event
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &e))
+	require.Equal(t, "RecordHonestActorClaims", e.Method)
+	require.Equal(t, addr.Hex(), e.Labels["actor"])
+	require.Equal(t, 3, e.Count)
+	require.Equal(t, "1", e.Labels["invalid_claims"])
+	require.Equal(t, "100", e.Labels["bond_required"])
+	require.Equal(t, "50", e.Labels["bond_available"])
+}
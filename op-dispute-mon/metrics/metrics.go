@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"math/big"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Namespace is the Prometheus/OTel namespace every dispute-mon metric is
+// registered under.
+const Namespace = "op_dispute_mon"
+
+// GameAgreementStatus classifies how the monitor's local view of a dispute
+// game compares against what's recorded on chain.
+type GameAgreementStatus int
+
+const (
+	AgreeChallengerWins GameAgreementStatus = iota
+	AgreeDefenderWins
+	DisagreeChallengerWins
+	DisagreeDefenderWins
+	AgreeGameInProgress
+	DisagreeGameInProgress
+)
+
+func (s GameAgreementStatus) String() string {
+	switch s {
+	case AgreeChallengerWins:
+		return "agree_challenger_wins"
+	case AgreeDefenderWins:
+		return "agree_defender_wins"
+	case DisagreeChallengerWins:
+		return "disagree_challenger_wins"
+	case DisagreeDefenderWins:
+		return "disagree_defender_wins"
+	case AgreeGameInProgress:
+		return "agree_game_in_progress"
+	case DisagreeGameInProgress:
+		return "disagree_game_in_progress"
+	default:
+		return "unknown"
+	}
+}
+
+// ClaimStatus classifies a single claim in a dispute game by its resolution
+// outcome.
+type ClaimStatus int
+
+const (
+	ClaimStatusInProgress ClaimStatus = iota
+	ClaimStatusResolved
+	ClaimStatusAgreement
+	ClaimStatusDisagreement
+)
+
+func (s ClaimStatus) String() string {
+	switch s {
+	case ClaimStatusInProgress:
+		return "in_progress"
+	case ClaimStatusResolved:
+		return "resolved"
+	case ClaimStatusAgreement:
+		return "agreement"
+	case ClaimStatusDisagreement:
+		return "disagreement"
+	default:
+		return "unknown"
+	}
+}
+
+// CreditExpectation classifies the monitor's expectation for an honest
+// actor's credit compared to what's recorded on chain.
+type CreditExpectation int
+
+const (
+	CreditBelowMaxExpectation CreditExpectation = iota
+	CreditEqualMaxExpectation
+	CreditAboveMaxExpectation
+)
+
+func (c CreditExpectation) String() string {
+	switch c {
+	case CreditBelowMaxExpectation:
+		return "below_max"
+	case CreditEqualMaxExpectation:
+		return "equal_max"
+	case CreditAboveMaxExpectation:
+		return "above_max"
+	default:
+		return "unknown"
+	}
+}
+
+// HonestActorData captures the per-address bookkeeping the monitor tracks
+// for an honest actor across the games it's participating in.
+type HonestActorData struct {
+	Claims           int
+	InvalidClaims    int
+	MaxBondRequired  *big.Int
+	MaxBondAvailable *big.Int
+}
+
+// Metricer is the instrumentation surface implemented by every dispute-mon
+// metrics sink (Prometheus, OpenTelemetry, StatsD, JSON event stream, ...).
+// NoopMetrics satisfies it with no-ops for tests and callers that don't want
+// to pay the cost of instrumentation.
+type Metricer interface {
+	contractMetrics.ContractMetricer
+
+	RecordInfo(version string)
+	RecordUp()
+
+	CacheAdd(label string, cacheSize int, evicted bool)
+	CacheGet(label string, hit bool)
+
+	RecordHonestActorClaims(address common.Address, data *HonestActorData)
+	RecordGameResolutionStatus(inProgress bool, resolved bool, count int)
+	RecordCredit(expectation CreditExpectation, count int)
+	RecordClaims(status ClaimStatus, count int)
+	RecordWithdrawalRequests(delta common.Address, matches bool, count int)
+	RecordClaimResolutionDelayMax(delay float64)
+	RecordOutputFetchTime(timestamp float64)
+	RecordGameAgreement(status GameAgreementStatus, count int)
+	RecordIgnoredGames(count int)
+	RecordBondCollateral(addr common.Address, required, available *big.Int)
+}
@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"math/big"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MultiMetricer fans every Metricer call out to a set of delegates, so a
+// caller can compose e.g. Prometheus and a JSON event stream without either
+// sink knowing about the other.
+//
+// The embedded ContractMetricer is taken from the first delegate, since the
+// underlying contract call metrics aren't meaningful to duplicate across
+// sinks that don't share a registry.
+type MultiMetricer struct {
+	contractMetrics.ContractMetricer
+	delegates []Metricer
+}
+
+var _ Metricer = (*MultiMetricer)(nil)
+
+// NewMultiMetricer returns a Metricer that forwards every recorded
+// measurement to each of delegates, in order.
+func NewMultiMetricer(delegates ...Metricer) *MultiMetricer {
+	m := &MultiMetricer{delegates: delegates}
+	if len(delegates) > 0 {
+		m.ContractMetricer = delegates[0]
+	} else {
+		m.ContractMetricer = contractMetrics.NoopMetrics{}
+	}
+	return m
+}
+
+func (m *MultiMetricer) RecordInfo(version string) {
+	for _, d := range m.delegates {
+		d.RecordInfo(version)
+	}
+}
+
+func (m *MultiMetricer) RecordUp() {
+	for _, d := range m.delegates {
+		d.RecordUp()
+	}
+}
+
+func (m *MultiMetricer) CacheAdd(label string, cacheSize int, evicted bool) {
+	for _, d := range m.delegates {
+		d.CacheAdd(label, cacheSize, evicted)
+	}
+}
+
+func (m *MultiMetricer) CacheGet(label string, hit bool) {
+	for _, d := range m.delegates {
+		d.CacheGet(label, hit)
+	}
+}
+
+func (m *MultiMetricer) RecordHonestActorClaims(address common.Address, data *HonestActorData) {
+	for _, d := range m.delegates {
+		d.RecordHonestActorClaims(address, data)
+	}
+}
+
+func (m *MultiMetricer) RecordGameResolutionStatus(inProgress bool, resolved bool, count int) {
+	for _, d := range m.delegates {
+		d.RecordGameResolutionStatus(inProgress, resolved, count)
+	}
+}
+
+func (m *MultiMetricer) RecordCredit(expectation CreditExpectation, count int) {
+	for _, d := range m.delegates {
+		d.RecordCredit(expectation, count)
+	}
+}
+
+func (m *MultiMetricer) RecordClaims(status ClaimStatus, count int) {
+	for _, d := range m.delegates {
+		d.RecordClaims(status, count)
+	}
+}
+
+func (m *MultiMetricer) RecordWithdrawalRequests(delta common.Address, matches bool, count int) {
+	for _, d := range m.delegates {
+		d.RecordWithdrawalRequests(delta, matches, count)
+	}
+}
+
+func (m *MultiMetricer) RecordClaimResolutionDelayMax(delay float64) {
+	for _, d := range m.delegates {
+		d.RecordClaimResolutionDelayMax(delay)
+	}
+}
+
+func (m *MultiMetricer) RecordOutputFetchTime(timestamp float64) {
+	for _, d := range m.delegates {
+		d.RecordOutputFetchTime(timestamp)
+	}
+}
+
+func (m *MultiMetricer) RecordGameAgreement(status GameAgreementStatus, count int) {
+	for _, d := range m.delegates {
+		d.RecordGameAgreement(status, count)
+	}
+}
+
+func (m *MultiMetricer) RecordIgnoredGames(count int) {
+	for _, d := range m.delegates {
+		d.RecordIgnoredGames(count)
+	}
+}
+
+func (m *MultiMetricer) RecordBondCollateral(addr common.Address, required, available *big.Int) {
+	for _, d := range m.delegates {
+		d.RecordBondCollateral(addr, required, available)
+	}
+}
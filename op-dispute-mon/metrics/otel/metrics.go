@@ -0,0 +1,386 @@
+// Package otel provides an OpenTelemetry-backed implementation of
+// metrics.Metricer, exporting to any OTLP collector instead of requiring a
+// Prometheus scrape target.
+package otel
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	dmetrics "github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Config controls where Metrics exports to. An empty CollectorAddr disables
+// export entirely, falling back to a noop.MeterProvider so behavior matches
+// dmetrics.NoopMetrics when OTel isn't configured.
+type Config struct {
+	CollectorAddr string
+	Insecure      bool
+
+	// TracerProvider supplies the spans Metrics wraps around game
+	// resolution, output fetch and bond collateral scan recordings. Nil
+	// falls back to a noop.TracerProvider.
+	TracerProvider trace.TracerProvider
+}
+
+// Metrics is an OpenTelemetry-backed metrics.Metricer. Each Record* call on
+// the interface maps onto OTel instruments obtained from a metric.Meter.
+//
+// Most Record* calls report a current-snapshot count (e.g. "N games are
+// currently in progress") rather than an incremental delta, so they're
+// backed by observable gauges via int64Gauge/float64Gauge rather than
+// counters: a counter would keep summing every scan's snapshot onto a
+// monotonically increasing total instead of reporting the latest value.
+type Metrics struct {
+	contractMetrics.ContractMetricer
+
+	provider metric.MeterProvider
+	shutdown func(context.Context) error
+	tracer   *Tracer
+
+	info *int64Gauge
+	up   *int64Gauge
+
+	cacheAdd  *counter
+	cacheGet  *counter
+	cacheSize *int64Gauge
+
+	claims          *int64Gauge
+	withdrawals     *int64Gauge
+	gameAgreement   *int64Gauge
+	ignoredGames    *int64Gauge
+	gameResolutions *int64Gauge
+	credit          *int64Gauge
+
+	honestActorClaims        *int64Gauge
+	honestActorInvalidClaims *int64Gauge
+	honestActorBondRequired  *float64Gauge
+	honestActorBondAvailable *float64Gauge
+
+	bondCollateral *float64Gauge
+
+	claimResolutionDelayMax metric.Float64Histogram
+	outputFetchTime         metric.Float64Histogram
+}
+
+// counter wraps an Int64Counter, purely so call sites read like the
+// Prometheus *Vec equivalents.
+type counter struct {
+	instrument metric.Int64Counter
+}
+
+func (c *counter) add(ctx context.Context, n int64, attrs ...attribute.KeyValue) {
+	c.instrument.Add(ctx, n, metric.WithAttributes(attrs...))
+}
+
+func newCounter(meter metric.Meter, name, help string) (*counter, error) {
+	instrument, err := meter.Int64Counter(name, metric.WithDescription(help))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s instrument: %w", name, err)
+	}
+	return &counter{instrument: instrument}, nil
+}
+
+// int64Gauge backs a Metricer method that reports a current-snapshot count
+// rather than a delta. OTel gauges are pull-based, so set stashes the
+// latest value per label-set key and the registered callback replays it on
+// every collection.
+type int64Gauge struct {
+	mu     sync.Mutex
+	values map[string]int64Sample
+}
+
+type int64Sample struct {
+	value int64
+	attrs []attribute.KeyValue
+}
+
+func newInt64Gauge(meter metric.Meter, name, help string) (*int64Gauge, error) {
+	g := &int64Gauge{values: make(map[string]int64Sample)}
+
+	inst, err := meter.Int64ObservableGauge(name, metric.WithDescription(help))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s instrument: %w", name, err)
+	}
+	if _, err := meter.RegisterCallback(g.observe(inst), inst); err != nil {
+		return nil, fmt.Errorf("failed to register %s callback: %w", name, err)
+	}
+	return g, nil
+}
+
+// set records value as the latest snapshot for the label set identified by
+// key, which must uniquely identify attrs (e.g. the label values joined).
+func (g *int64Gauge) set(key string, value int64, attrs ...attribute.KeyValue) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[key] = int64Sample{value: value, attrs: attrs}
+}
+
+func (g *int64Gauge) observe(inst metric.Int64Observable) metric.Callback {
+	return func(_ context.Context, o metric.Observer) error {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		for _, s := range g.values {
+			o.ObserveInt64(inst, s.value, metric.WithAttributes(s.attrs...))
+		}
+		return nil
+	}
+}
+
+// float64Gauge is the float64 counterpart of int64Gauge, used for bond
+// amounts that are reported as floats since wei values can exceed the
+// int64 range.
+type float64Gauge struct {
+	mu     sync.Mutex
+	values map[string]float64Sample
+}
+
+type float64Sample struct {
+	value float64
+	attrs []attribute.KeyValue
+}
+
+func newFloat64Gauge(meter metric.Meter, name, help string) (*float64Gauge, error) {
+	g := &float64Gauge{values: make(map[string]float64Sample)}
+
+	inst, err := meter.Float64ObservableGauge(name, metric.WithDescription(help), metric.WithUnit("{wei}"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s instrument: %w", name, err)
+	}
+	if _, err := meter.RegisterCallback(g.observe(inst), inst); err != nil {
+		return nil, fmt.Errorf("failed to register %s callback: %w", name, err)
+	}
+	return g, nil
+}
+
+func (g *float64Gauge) set(key string, value float64, attrs ...attribute.KeyValue) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[key] = float64Sample{value: value, attrs: attrs}
+}
+
+func (g *float64Gauge) observe(inst metric.Float64Observable) metric.Callback {
+	return func(_ context.Context, o metric.Observer) error {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		for _, s := range g.values {
+			o.ObserveFloat64(inst, s.value, metric.WithAttributes(s.attrs...))
+		}
+		return nil
+	}
+}
+
+var _ dmetrics.Metricer = (*Metrics)(nil)
+
+// NewMetrics builds an OTel-backed Metricer. When cfg.CollectorAddr is empty
+// it falls back to a noop.MeterProvider, so instrumentation is a
+// no-op exactly like dmetrics.NoopMetrics until a collector is configured.
+func NewMetrics(ctx context.Context, gameMetrics contractMetrics.ContractMetricer, cfg Config) (*Metrics, error) {
+	var provider metric.MeterProvider
+	shutdown := func(context.Context) error { return nil }
+
+	if cfg.CollectorAddr != "" {
+		opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.CollectorAddr)}
+		if cfg.Insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+
+		exporter, err := otlpmetricgrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+		}
+
+		res, err := resource.New(ctx, resource.WithAttributes(
+			semconv.ServiceName(dmetrics.Namespace),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build OTel resource: %w", err)
+		}
+
+		sdkProvider := sdkmetric.NewMeterProvider(
+			sdkmetric.WithResource(res),
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+		)
+		provider = sdkProvider
+		shutdown = sdkProvider.Shutdown
+	} else {
+		provider = noop.NewMeterProvider()
+	}
+
+	meter := provider.Meter(dmetrics.Namespace)
+
+	m := &Metrics{
+		ContractMetricer: gameMetrics,
+		provider:         provider,
+		shutdown:         shutdown,
+		tracer:           NewTracer(cfg.TracerProvider),
+	}
+
+	var err error
+	if m.info, err = newInt64Gauge(meter, "info", "Pseudo-metric tracking version and config info"); err != nil {
+		return nil, err
+	}
+	if m.up, err = newInt64Gauge(meter, "up", "1 if the dispute monitor has finished starting up"); err != nil {
+		return nil, err
+	}
+	if m.cacheAdd, err = newCounter(meter, "cache_add", "Count of cache additions"); err != nil {
+		return nil, err
+	}
+	if m.cacheGet, err = newCounter(meter, "cache_get", "Count of cache lookups"); err != nil {
+		return nil, err
+	}
+	if m.cacheSize, err = newInt64Gauge(meter, "cache_size", "Current number of entries in a cache"); err != nil {
+		return nil, err
+	}
+	if m.claims, err = newInt64Gauge(meter, "claims", "Number of claims observed, labelled by status"); err != nil {
+		return nil, err
+	}
+	if m.withdrawals, err = newInt64Gauge(meter, "withdrawal_requests", "Number of withdrawal requests observed"); err != nil {
+		return nil, err
+	}
+	if m.gameAgreement, err = newInt64Gauge(meter, "game_agreement", "Number of games observed, labelled by agreement status"); err != nil {
+		return nil, err
+	}
+	if m.ignoredGames, err = newInt64Gauge(meter, "ignored_games", "Number of games ignored by the monitor"); err != nil {
+		return nil, err
+	}
+	if m.gameResolutions, err = newInt64Gauge(meter, "game_resolutions", "Number of game resolution status observations"); err != nil {
+		return nil, err
+	}
+	if m.credit, err = newInt64Gauge(meter, "credit", "Number of credits observed, labelled by expectation relative to the max"); err != nil {
+		return nil, err
+	}
+	if m.honestActorClaims, err = newInt64Gauge(meter, "honest_actor_claims", "Number of claims posted by a tracked honest actor"); err != nil {
+		return nil, err
+	}
+	if m.honestActorInvalidClaims, err = newInt64Gauge(meter, "honest_actor_invalid_claims", "Number of invalid claims posted by a tracked honest actor"); err != nil {
+		return nil, err
+	}
+	if m.honestActorBondRequired, err = newFloat64Gauge(meter, "honest_actor_bond_required", "Maximum bond required across a tracked honest actor's claims"); err != nil {
+		return nil, err
+	}
+	if m.honestActorBondAvailable, err = newFloat64Gauge(meter, "honest_actor_bond_available", "Maximum bond available across a tracked honest actor's claims"); err != nil {
+		return nil, err
+	}
+	if m.bondCollateral, err = newFloat64Gauge(meter, "bond_collateral", "Required and available bond collateral, in wei"); err != nil {
+		return nil, err
+	}
+
+	if m.claimResolutionDelayMax, err = meter.Float64Histogram("claim_resolution_delay_max",
+		metric.WithDescription("Maximum observed delay before a claim's resolution deadline"),
+		metric.WithUnit("s")); err != nil {
+		return nil, fmt.Errorf("failed to create claim_resolution_delay_max instrument: %w", err)
+	}
+
+	if m.outputFetchTime, err = meter.Float64Histogram("output_fetch_time",
+		metric.WithDescription("Time taken to fetch an output root to compare against"),
+		metric.WithUnit("s")); err != nil {
+		return nil, fmt.Errorf("failed to create output_fetch_time instrument: %w", err)
+	}
+
+	return m, nil
+}
+
+// Shutdown flushes and closes the underlying OTel meter provider, if one is
+// configured.
+func (m *Metrics) Shutdown(ctx context.Context) error {
+	return m.shutdown(ctx)
+}
+
+func (m *Metrics) RecordInfo(version string) {
+	m.info.set("", 1, attribute.String("version", version))
+}
+
+func (m *Metrics) RecordUp() {
+	m.up.set("", 1)
+}
+
+func (m *Metrics) CacheAdd(label string, cacheSize int, evicted bool) {
+	m.cacheAdd.add(context.Background(), 1, attribute.String("cache", label), attribute.Bool("evicted", evicted))
+	m.cacheSize.set(label, int64(cacheSize), attribute.String("cache", label))
+}
+
+func (m *Metrics) CacheGet(label string, hit bool) {
+	m.cacheGet.add(context.Background(), 1, attribute.String("cache", label), attribute.Bool("hit", hit))
+}
+
+func (m *Metrics) RecordHonestActorClaims(address common.Address, data *dmetrics.HonestActorData) {
+	addr := address.Hex()
+	m.honestActorClaims.set(addr, int64(data.Claims), attribute.String("actor", addr))
+	m.honestActorInvalidClaims.set(addr, int64(data.InvalidClaims), attribute.String("actor", addr))
+	m.honestActorBondRequired.set(addr, bigToFloat(data.MaxBondRequired), attribute.String("actor", addr))
+	m.honestActorBondAvailable.set(addr, bigToFloat(data.MaxBondAvailable), attribute.String("actor", addr))
+}
+
+func (m *Metrics) RecordGameResolutionStatus(inProgress bool, resolved bool, count int) {
+	_, span := m.tracer.GameResolution(context.Background(), inProgress, resolved, count)
+	defer span.End()
+
+	key := fmt.Sprintf("%t:%t", inProgress, resolved)
+	m.gameResolutions.set(key, int64(count),
+		attribute.Bool("in_progress", inProgress), attribute.Bool("resolved", resolved))
+}
+
+func (m *Metrics) RecordCredit(expectation dmetrics.CreditExpectation, count int) {
+	m.credit.set(expectation.String(), int64(count), attribute.String("expectation", expectation.String()))
+}
+
+func (m *Metrics) RecordClaims(status dmetrics.ClaimStatus, count int) {
+	m.claims.set(status.String(), int64(count), attribute.String("status", status.String()))
+}
+
+func (m *Metrics) RecordWithdrawalRequests(delta common.Address, matches bool, count int) {
+	key := fmt.Sprintf("%s:%t", delta.Hex(), matches)
+	m.withdrawals.set(key, int64(count),
+		attribute.String("delta", delta.Hex()), attribute.Bool("matches", matches))
+}
+
+func (m *Metrics) RecordClaimResolutionDelayMax(delay float64) {
+	m.claimResolutionDelayMax.Record(context.Background(), delay)
+}
+
+func (m *Metrics) RecordOutputFetchTime(timestamp float64) {
+	ctx, span := m.tracer.OutputFetch(context.Background())
+	span.SetAttributes(attribute.Float64("seconds", timestamp))
+	defer span.End()
+
+	m.outputFetchTime.Record(ctx, timestamp)
+}
+
+func (m *Metrics) RecordGameAgreement(status dmetrics.GameAgreementStatus, count int) {
+	m.gameAgreement.set(status.String(), int64(count), attribute.String("status", status.String()))
+}
+
+func (m *Metrics) RecordIgnoredGames(count int) {
+	m.ignoredGames.set("", int64(count))
+}
+
+func (m *Metrics) RecordBondCollateral(addr common.Address, required, available *big.Int) {
+	_, span := m.tracer.BondCollateralScan(context.Background(), addr)
+	span.SetAttributes(attribute.Float64("required", bigToFloat(required)), attribute.Float64("available", bigToFloat(available)))
+	defer span.End()
+
+	hex := addr.Hex()
+	m.bondCollateral.set(hex+":required", bigToFloat(required), attribute.String("address", hex), attribute.String("kind", "required"))
+	m.bondCollateral.set(hex+":available", bigToFloat(available), attribute.String("address", hex), attribute.String("kind", "available"))
+}
+
+func bigToFloat(v *big.Int) float64 {
+	if v == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
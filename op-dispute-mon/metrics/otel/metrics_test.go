@@ -0,0 +1,92 @@
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestInt64GaugeReportsLatestValuePerKey(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter := provider.Meter("test")
+
+	g, err := newInt64Gauge(meter, "test_int64_gauge", "a test gauge")
+	require.NoError(t, err)
+
+	g.set("a", 1, attribute.String("key", "a"))
+	g.set("b", 2, attribute.String("key", "b"))
+	g.set("a", 5, attribute.String("key", "a")) // overwrites, doesn't sum
+
+	require.ElementsMatch(t, []int64{5, 2}, collectInt64GaugeValues(t, reader, "test_int64_gauge"))
+}
+
+func TestFloat64GaugeReportsLatestValuePerKey(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter := provider.Meter("test")
+
+	g, err := newFloat64Gauge(meter, "test_float64_gauge", "a test gauge")
+	require.NoError(t, err)
+
+	g.set("required", 100, attribute.String("kind", "required"))
+	g.set("required", 150, attribute.String("kind", "required"))
+
+	require.ElementsMatch(t, []float64{150}, collectFloat64GaugeValues(t, reader, "test_float64_gauge"))
+}
+
+func collectInt64GaugeValues(t *testing.T, reader sdkmetric.Reader, name string) []int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			gauge, ok := m.Data.(metricdata.Gauge[int64])
+			require.True(t, ok, "expected an int64 gauge for %s", name)
+
+			values := make([]int64, len(gauge.DataPoints))
+			for i, dp := range gauge.DataPoints {
+				values[i] = dp.Value
+			}
+			return values
+		}
+	}
+
+	t.Fatalf("metric %s not found", name)
+	return nil
+}
+
+func collectFloat64GaugeValues(t *testing.T, reader sdkmetric.Reader, name string) []float64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			gauge, ok := m.Data.(metricdata.Gauge[float64])
+			require.True(t, ok, "expected a float64 gauge for %s", name)
+
+			values := make([]float64, len(gauge.DataPoints))
+			for i, dp := range gauge.DataPoints {
+				values[i] = dp.Value
+			}
+			return values
+		}
+	}
+
+	t.Fatalf("metric %s not found", name)
+	return nil
+}
@@ -0,0 +1,70 @@
+package otel
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// TracerName is the instrumentation scope used for every span started by
+// the dispute monitor.
+const TracerName = "github.com/ethereum-optimism/optimism/op-dispute-mon"
+
+// Tracer wraps spans around the code paths that also feed Metrics, so the
+// same measurements recorded as metric instruments are attached to the span
+// as attributes for trace-level debugging. It falls back to a
+// noop.TracerProvider when tracing isn't configured, matching the
+// noop-by-default behavior of dmetrics.NoopMetrics.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer builds a Tracer from provider, or from a new
+// noop.TracerProvider if provider is nil.
+func NewTracer(provider trace.TracerProvider) *Tracer {
+	if provider == nil {
+		provider = noop.NewTracerProvider()
+	}
+	return &Tracer{tracer: provider.Tracer(TracerName)}
+}
+
+// GameResolution wraps a game resolution check in a span, recording the
+// same in-progress/resolved/count measurements Metrics.RecordGameResolutionStatus
+// observes.
+func (t *Tracer) GameResolution(ctx context.Context, inProgress, resolved bool, count int) (context.Context, trace.Span) {
+	ctx, span := t.tracer.Start(ctx, "game_resolution")
+	span.SetAttributes(
+		attribute.Bool("in_progress", inProgress),
+		attribute.Bool("resolved", resolved),
+		attribute.Int("count", count),
+	)
+	return ctx, span
+}
+
+// OutputFetch wraps an output root fetch in a span. Callers should defer
+// End. The span starts with no attributes; Metrics.RecordOutputFetchTime
+// sets the observed fetch time, in seconds, as its "seconds" attribute.
+func (t *Tracer) OutputFetch(ctx context.Context) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, "output_fetch")
+}
+
+// BondCollateralScan wraps a bond collateral scan for addr in a span.
+func (t *Tracer) BondCollateralScan(ctx context.Context, addr common.Address) (context.Context, trace.Span) {
+	ctx, span := t.tracer.Start(ctx, "bond_collateral_scan")
+	span.SetAttributes(attribute.String("address", addr.Hex()))
+	return ctx, span
+}
+
+// EndWithError records err on the span, if any, and sets the span status
+// accordingly before ending it.
+func EndWithError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,35 @@
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracerRecordsSpansForWrappedCallPaths(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracer := NewTracer(provider)
+
+	_, gameResolutionSpan := tracer.GameResolution(context.Background(), true, false, 3)
+	gameResolutionSpan.End()
+
+	_, outputFetchSpan := tracer.OutputFetch(context.Background())
+	outputFetchSpan.End()
+
+	_, bondScanSpan := tracer.BondCollateralScan(context.Background(), common.HexToAddress("0x1111111111111111111111111111111111111111"))
+	bondScanSpan.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	names := make([]string, len(spans))
+	for i, s := range spans {
+		names[i] = s.Name
+	}
+	require.ElementsMatch(t, []string{"game_resolution", "output_fetch", "bond_collateral_scan"}, names)
+}
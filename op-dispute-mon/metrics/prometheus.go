@@ -0,0 +1,286 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// quantileObjectives mirrors the go-kit metrics/prometheus default
+// objectives, giving p50/p90/p99 with a tight epsilon suitable for the
+// latency and delay measurements recorded below.
+var quantileObjectives = map[float64]float64{
+	0.5:  0.05,
+	0.9:  0.01,
+	0.99: 0.001,
+}
+
+// PrometheusMetrics is the Prometheus-backed Metricer implementation used in
+// production. It registers counters, gauges and summaries against the
+// supplied registry so operators can scrape them over HTTP.
+type PrometheusMetrics struct {
+	contractMetrics.ContractMetricer
+
+	ns       string
+	registry *prometheus.Registry
+
+	info *prometheus.GaugeVec
+	up   prometheus.Gauge
+
+	cacheAdd  *prometheus.CounterVec
+	cacheGet  *prometheus.CounterVec
+	cacheSize *prometheus.GaugeVec
+
+	honestActorClaims        *prometheus.GaugeVec
+	honestActorInvalidClaims *prometheus.GaugeVec
+	honestActorBondRequired  *prometheus.GaugeVec
+	honestActorBondAvailable *prometheus.GaugeVec
+
+	gamesInProgress *prometheus.GaugeVec
+	credit          *prometheus.GaugeVec
+	claims          *prometheus.GaugeVec
+	withdrawals     *prometheus.GaugeVec
+	gameAgreement   *prometheus.GaugeVec
+	ignoredGames    prometheus.Gauge
+
+	bondCollateralRequired  *prometheus.GaugeVec
+	bondCollateralAvailable *prometheus.GaugeVec
+
+	claimResolutionDelayMax *prometheus.SummaryVec
+	outputFetchTime         *prometheus.SummaryVec
+}
+
+var _ Metricer = (*PrometheusMetrics)(nil)
+
+// NewPrometheusMetrics constructs a PrometheusMetrics that registers all of
+// its instruments against registry under the given namespace.
+func NewPrometheusMetrics(registry *prometheus.Registry, namespace string) *PrometheusMetrics {
+	factory := func(opts prometheus.Opts) prometheus.Opts {
+		opts.Namespace = namespace
+		return opts
+	}
+
+	m := &PrometheusMetrics{
+		ContractMetricer: contractMetrics.NewMetrics(namespace, registry),
+
+		ns:       namespace,
+		registry: registry,
+
+		info: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "info",
+			Help: "Pseudo-metric tracking version and config info",
+		})), []string{"version"}),
+		up: prometheus.NewGauge(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "up",
+			Help: "1 if the dispute monitor has finished starting up",
+		}))),
+
+		cacheAdd: prometheus.NewCounterVec(prometheus.CounterOpts(factory(prometheus.Opts{
+			Name: "cache_add",
+			Help: "Count of cache additions, labelled by cache and whether an entry was evicted",
+		})), []string{"cache", "evicted"}),
+		cacheGet: prometheus.NewCounterVec(prometheus.CounterOpts(factory(prometheus.Opts{
+			Name: "cache_get",
+			Help: "Count of cache lookups, labelled by cache and hit/miss",
+		})), []string{"cache", "hit"}),
+		cacheSize: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "cache_size",
+			Help: "Current number of entries in a cache",
+		})), []string{"cache"}),
+
+		honestActorClaims: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "honest_actor_claims",
+			Help: "Number of claims posted by a tracked honest actor",
+		})), []string{"actor"}),
+		honestActorInvalidClaims: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "honest_actor_invalid_claims",
+			Help: "Number of invalid claims posted by a tracked honest actor",
+		})), []string{"actor"}),
+		honestActorBondRequired: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "honest_actor_bond_required",
+			Help: "Maximum bond required across a tracked honest actor's claims",
+		})), []string{"actor"}),
+		honestActorBondAvailable: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "honest_actor_bond_available",
+			Help: "Maximum bond available across a tracked honest actor's claims",
+		})), []string{"actor"}),
+
+		gamesInProgress: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "games",
+			Help: "Number of games the monitor is tracking, labelled by resolution status",
+		})), []string{"in_progress", "resolved"}),
+		credit: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "credit",
+			Help: "Number of credits observed, labelled by expectation relative to the max",
+		})), []string{"expectation"}),
+		claims: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "claims",
+			Help: "Number of claims observed, labelled by status",
+		})), []string{"status"}),
+		withdrawals: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "withdrawal_requests",
+			Help: "Number of withdrawal requests observed, labelled by delta actor and match",
+		})), []string{"delta", "matches"}),
+		gameAgreement: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "game_agreement",
+			Help: "Number of games, labelled by agreement status",
+		})), []string{"status"}),
+		ignoredGames: prometheus.NewGauge(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "ignored_games",
+			Help: "Number of games ignored by the monitor",
+		}))),
+
+		bondCollateralRequired: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "bond_collateral_required",
+			Help: "Required bond collateral for an address, in wei",
+		})), []string{"address"}),
+		bondCollateralAvailable: prometheus.NewGaugeVec(prometheus.GaugeOpts(factory(prometheus.Opts{
+			Name: "bond_collateral_available",
+			Help: "Available bond collateral for an address, in wei",
+		})), []string{"address"}),
+
+		claimResolutionDelayMax: prometheus.NewSummaryVec(prometheus.SummaryOpts{
+			Namespace:  namespace,
+			Name:       "claim_resolution_delay_max_seconds",
+			Help:       "Maximum observed delay before a claim's resolution deadline, in seconds",
+			Objectives: quantileObjectives,
+		}, []string{}),
+		outputFetchTime: prometheus.NewSummaryVec(prometheus.SummaryOpts{
+			Namespace:  namespace,
+			Name:       "output_fetch_time_seconds",
+			Help:       "Time taken to fetch an output root to compare against, in seconds",
+			Objectives: quantileObjectives,
+		}, []string{}),
+	}
+
+	registry.MustRegister(
+		m.info,
+		m.up,
+		m.cacheAdd,
+		m.cacheGet,
+		m.cacheSize,
+		m.honestActorClaims,
+		m.honestActorInvalidClaims,
+		m.honestActorBondRequired,
+		m.honestActorBondAvailable,
+		m.gamesInProgress,
+		m.credit,
+		m.claims,
+		m.withdrawals,
+		m.gameAgreement,
+		m.ignoredGames,
+		m.bondCollateralRequired,
+		m.bondCollateralAvailable,
+		m.claimResolutionDelayMax,
+		m.outputFetchTime,
+	)
+
+	return m
+}
+
+// Start serves the registered metrics as a Prometheus text exposition on
+// host:port at /metrics until ctx is cancelled.
+func (m *PrometheusMetrics) Start(ctx context.Context, host string, port int) (*http.Server, error) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	go func() {
+		_ = server.Serve(ln)
+	}()
+
+	return server, nil
+}
+
+func (m *PrometheusMetrics) RecordInfo(version string) {
+	m.info.WithLabelValues(version).Set(1)
+}
+
+func (m *PrometheusMetrics) RecordUp() {
+	m.up.Set(1)
+}
+
+func (m *PrometheusMetrics) CacheAdd(label string, cacheSize int, evicted bool) {
+	m.cacheAdd.WithLabelValues(label, strconv.FormatBool(evicted)).Inc()
+	m.cacheSize.WithLabelValues(label).Set(float64(cacheSize))
+}
+
+func (m *PrometheusMetrics) CacheGet(label string, hit bool) {
+	m.cacheGet.WithLabelValues(label, strconv.FormatBool(hit)).Inc()
+}
+
+func (m *PrometheusMetrics) RecordHonestActorClaims(address common.Address, data *HonestActorData) {
+	addr := address.Hex()
+	m.honestActorClaims.WithLabelValues(addr).Set(float64(data.Claims))
+	m.honestActorInvalidClaims.WithLabelValues(addr).Set(float64(data.InvalidClaims))
+	m.honestActorBondRequired.WithLabelValues(addr).Set(bigToFloat(data.MaxBondRequired))
+	m.honestActorBondAvailable.WithLabelValues(addr).Set(bigToFloat(data.MaxBondAvailable))
+}
+
+func (m *PrometheusMetrics) RecordGameResolutionStatus(inProgress bool, resolved bool, count int) {
+	m.gamesInProgress.WithLabelValues(strconv.FormatBool(inProgress), strconv.FormatBool(resolved)).Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordCredit(expectation CreditExpectation, count int) {
+	m.credit.WithLabelValues(expectation.String()).Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordClaims(status ClaimStatus, count int) {
+	m.claims.WithLabelValues(status.String()).Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordWithdrawalRequests(delta common.Address, matches bool, count int) {
+	m.withdrawals.WithLabelValues(delta.Hex(), strconv.FormatBool(matches)).Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordClaimResolutionDelayMax(delay float64) {
+	m.claimResolutionDelayMax.WithLabelValues().Observe(delay)
+}
+
+func (m *PrometheusMetrics) RecordOutputFetchTime(timestamp float64) {
+	m.outputFetchTime.WithLabelValues().Observe(timestamp)
+}
+
+func (m *PrometheusMetrics) RecordGameAgreement(status GameAgreementStatus, count int) {
+	m.gameAgreement.WithLabelValues(status.String()).Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordIgnoredGames(count int) {
+	m.ignoredGames.Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordBondCollateral(addr common.Address, required, available *big.Int) {
+	a := addr.Hex()
+	m.bondCollateralRequired.WithLabelValues(a).Set(bigToFloat(required))
+	m.bondCollateralAvailable.WithLabelValues(a).Set(bigToFloat(available))
+}
+
+// bigToFloat converts a *big.Int to a float64 for gauge reporting, treating
+// nil as zero.
+func bigToFloat(v *big.Int) float64 {
+	if v == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
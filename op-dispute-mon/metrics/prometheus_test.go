@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func gaugeValue(t *testing.T, g prometheus.Gauge) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, g.Write(m))
+	return m.GetGauge().GetValue()
+}
+
+func TestPrometheusMetricsCacheAddRecordsSize(t *testing.T) {
+	m := NewPrometheusMetrics(prometheus.NewRegistry(), "test")
+
+	m.CacheAdd("claims", 12, false)
+
+	require.Equal(t, float64(12), gaugeValue(t, m.cacheSize.WithLabelValues("claims")))
+}
+
+func TestPrometheusMetricsRecordBondCollateral(t *testing.T) {
+	m := NewPrometheusMetrics(prometheus.NewRegistry(), "test")
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	m.RecordBondCollateral(addr, big.NewInt(100), big.NewInt(50))
+
+	require.Equal(t, float64(100), gaugeValue(t, m.bondCollateralRequired.WithLabelValues(addr.Hex())))
+	require.Equal(t, float64(50), gaugeValue(t, m.bondCollateralAvailable.WithLabelValues(addr.Hex())))
+}
@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	statsd "github.com/cactus/go-statsd-client/v5/statsd"
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StatsDMetrics is a Metricer implementation backed by a DogStatsD client.
+// It's a push-based alternative to PrometheusMetrics for environments where
+// operators would rather receive UDP metrics than run a scrape target.
+type StatsDMetrics struct {
+	contractMetrics.ContractMetricer
+
+	client     statsd.Statter
+	sampleRate float32
+}
+
+var _ Metricer = (*StatsDMetrics)(nil)
+
+// NewStatsDMetrics dials addr and returns a StatsDMetrics that prefixes
+// every stat name with prefix and sends at sampleRate (1.0 sends every
+// measurement; lower values randomly sample to cut UDP volume on hot
+// paths). Stats are buffered and flushed asynchronously so CacheAdd,
+// CacheGet and RecordClaims never block on the network.
+func NewStatsDMetrics(addr, prefix string, sampleRate float64) (*StatsDMetrics, error) {
+	client, err := statsd.NewBufferedClient(addr, prefix, statsd.DefaultFlushInterval, statsd.DefaultFlushBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create statsd client for %s: %w", addr, err)
+	}
+
+	return &StatsDMetrics{
+		ContractMetricer: contractMetrics.NoopMetrics{},
+		client:           client,
+		sampleRate:       float32(sampleRate),
+	}, nil
+}
+
+// Close flushes and closes the underlying statsd client.
+func (m *StatsDMetrics) Close() error {
+	return m.client.Close()
+}
+
+func addrTag(addr common.Address) statsd.Tag {
+	return statsd.Tag{"address", addr.Hex()}
+}
+
+func (m *StatsDMetrics) RecordInfo(version string) {
+	_ = m.client.Gauge("info", 1, m.sampleRate, statsd.Tag{"version", version})
+}
+
+func (m *StatsDMetrics) RecordUp() {
+	_ = m.client.Gauge("up", 1, m.sampleRate)
+}
+
+func (m *StatsDMetrics) CacheAdd(label string, cacheSize int, evicted bool) {
+	_ = m.client.Inc("cache_add", 1, m.sampleRate, statsd.Tag{"cache", label}, statsd.Tag{"evicted", strconv.FormatBool(evicted)})
+	_ = m.client.Gauge("cache_size", int64(cacheSize), m.sampleRate, statsd.Tag{"cache", label})
+}
+
+func (m *StatsDMetrics) CacheGet(label string, hit bool) {
+	_ = m.client.Inc("cache_get", 1, m.sampleRate, statsd.Tag{"cache", label}, statsd.Tag{"hit", strconv.FormatBool(hit)})
+}
+
+func (m *StatsDMetrics) RecordHonestActorClaims(address common.Address, data *HonestActorData) {
+	tag := addrTag(address)
+	_ = m.client.Gauge("honest_actor_claims", int64(data.Claims), m.sampleRate, tag)
+	_ = m.client.Gauge("honest_actor_invalid_claims", int64(data.InvalidClaims), m.sampleRate, tag)
+	_ = m.client.Gauge("honest_actor_bond_required", bigToInt64(data.MaxBondRequired), m.sampleRate, tag)
+	_ = m.client.Gauge("honest_actor_bond_available", bigToInt64(data.MaxBondAvailable), m.sampleRate, tag)
+}
+
+func (m *StatsDMetrics) RecordGameResolutionStatus(inProgress bool, resolved bool, count int) {
+	_ = m.client.Gauge("games", int64(count), m.sampleRate,
+		statsd.Tag{"in_progress", strconv.FormatBool(inProgress)}, statsd.Tag{"resolved", strconv.FormatBool(resolved)})
+}
+
+func (m *StatsDMetrics) RecordCredit(expectation CreditExpectation, count int) {
+	_ = m.client.Gauge("credit", int64(count), m.sampleRate, statsd.Tag{"expectation", expectation.String()})
+}
+
+func (m *StatsDMetrics) RecordClaims(status ClaimStatus, count int) {
+	_ = m.client.Gauge("claims", int64(count), m.sampleRate, statsd.Tag{"status", status.String()})
+}
+
+func (m *StatsDMetrics) RecordWithdrawalRequests(delta common.Address, matches bool, count int) {
+	_ = m.client.Gauge("withdrawal_requests", int64(count), m.sampleRate,
+		statsd.Tag{"delta", delta.Hex()}, statsd.Tag{"matches", strconv.FormatBool(matches)})
+}
+
+func (m *StatsDMetrics) RecordClaimResolutionDelayMax(delay float64) {
+	_ = m.client.TimingDuration("claim_resolution_delay_max", secondsToDuration(delay), m.sampleRate)
+}
+
+func (m *StatsDMetrics) RecordOutputFetchTime(timestamp float64) {
+	_ = m.client.TimingDuration("output_fetch_time", secondsToDuration(timestamp), m.sampleRate)
+}
+
+func (m *StatsDMetrics) RecordGameAgreement(status GameAgreementStatus, count int) {
+	_ = m.client.Gauge("game_agreement", int64(count), m.sampleRate, statsd.Tag{"status", status.String()})
+}
+
+func (m *StatsDMetrics) RecordIgnoredGames(count int) {
+	_ = m.client.Gauge("ignored_games", int64(count), m.sampleRate)
+}
+
+func (m *StatsDMetrics) RecordBondCollateral(addr common.Address, required, available *big.Int) {
+	tag := addrTag(addr)
+	_ = m.client.Gauge("bond_collateral_required", bigToInt64(required), m.sampleRate, tag)
+	_ = m.client.Gauge("bond_collateral_available", bigToInt64(available), m.sampleRate, tag)
+}
+
+// bigToInt64 converts v to an int64 gauge value for the statsd client,
+// which has no arbitrary-precision numeric type. v.Int64() would silently
+// wrap for wei amounts beyond the int64 range (~9.2 ETH), so instead we
+// round through a big.Float and clamp to the int64 range, matching the
+// float64 conversion the OTel sink uses for the same values.
+func bigToInt64(v *big.Int) int64 {
+	if v == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(v).Float64()
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	default:
+		return int64(f)
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigToInt64(t *testing.T) {
+	require.EqualValues(t, 0, bigToInt64(nil))
+	require.EqualValues(t, 42, bigToInt64(big.NewInt(42)))
+	require.EqualValues(t, -42, bigToInt64(big.NewInt(-42)))
+
+	huge := new(big.Int).Lsh(big.NewInt(1), 100)
+	require.EqualValues(t, math.MaxInt64, bigToInt64(huge))
+
+	hugeNeg := new(big.Int).Neg(huge)
+	require.EqualValues(t, math.MinInt64, bigToInt64(hugeNeg))
+}
+
+func TestAddrTag(t *testing.T) {
+	addr := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	require.Equal(t, addr.Hex(), addrTag(addr)[1])
+	require.Equal(t, "address", addrTag(addr)[0])
+}